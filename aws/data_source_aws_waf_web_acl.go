@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsWafWebAcl() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsWafWebAclRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"metric_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_action": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"rules": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"override_action": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsWafWebAclRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafconn
+	name := d.Get("name").(string)
+
+	webACLId, err := findWafWebAclIdByName(conn, name)
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.GetWebACL(&waf.GetWebACLInput{
+		WebACLId: webACLId,
+	})
+	if err != nil {
+		return fmt.Errorf("error reading WAF ACL (%s): %s", aws.StringValue(webACLId), err)
+	}
+
+	d.SetId(aws.StringValue(resp.WebACL.WebACLId))
+	d.Set("metric_name", resp.WebACL.MetricName)
+	if err := d.Set("default_action", flattenDefaultAction(resp.WebACL.DefaultAction)); err != nil {
+		return fmt.Errorf("error setting default_action: %s", err)
+	}
+	if err := d.Set("rules", flattenWafWebAclRules(resp.WebACL.Rules)); err != nil {
+		return fmt.Errorf("error setting rules: %s", err)
+	}
+
+	return nil
+}
+
+func findWafWebAclIdByName(conn *waf.WAF, name string) (*string, error) {
+	input := &waf.ListWebACLsInput{
+		Limit: aws.Int64(100),
+	}
+
+	for {
+		resp, err := conn.ListWebACLs(input)
+		if err != nil {
+			return nil, fmt.Errorf("error reading WAF ACLs: %s", err)
+		}
+
+		for _, webACL := range resp.WebACLs {
+			if aws.StringValue(webACL.Name) == name {
+				return webACL.WebACLId, nil
+			}
+		}
+
+		if resp.NextMarker == nil {
+			break
+		}
+		input.NextMarker = resp.NextMarker
+	}
+
+	return nil, fmt.Errorf("WAF ACL not found for name: %s", name)
+}
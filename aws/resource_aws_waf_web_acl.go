@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"reflect"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
@@ -10,6 +11,10 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// wafWebAclMaxBatchUpdates is the maximum number of rule updates AWS will
+// accept in a single UpdateWebACL call.
+const wafWebAclMaxBatchUpdates = 1000
+
 func resourceAwsWafWebAcl() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsWafWebAclCreate,
@@ -46,7 +51,7 @@ func resourceAwsWafWebAcl() *schema.Resource {
 				ValidateFunc: validateWafMetricName,
 			},
 			"rules": {
-				Type:     schema.TypeSet,
+				Type:     schema.TypeList,
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -97,6 +102,53 @@ func resourceAwsWafWebAcl() *schema.Resource {
 					},
 				},
 			},
+			"logging_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_destination": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"redacted_fields": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field_to_match": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"type": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"data": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -120,6 +172,14 @@ func resourceAwsWafWebAclCreate(d *schema.ResourceData, meta interface{}) error
 	}
 	resp := out.(*waf.CreateWebACLOutput)
 	d.SetId(*resp.WebACL.WebACLId)
+	d.Set("arn", resp.WebACL.WebACLArn)
+
+	if v, ok := d.GetOk("tags"); ok {
+		if err := createWafTags(conn, aws.StringValue(resp.WebACL.WebACLArn), v.(map[string]interface{})); err != nil {
+			return fmt.Errorf("Error tagging WAF ACL (%s): %s", *resp.WebACL.WebACLId, err)
+		}
+	}
+
 	return resourceAwsWafWebAclUpdate(d, meta)
 }
 
@@ -148,30 +208,97 @@ func resourceAwsWafWebAclRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("name", resp.WebACL.Name)
 	d.Set("metric_name", resp.WebACL.MetricName)
+	d.Set("arn", resp.WebACL.WebACLArn)
 	if err := d.Set("rules", flattenWafWebAclRules(resp.WebACL.Rules)); err != nil {
 		return fmt.Errorf("error setting rules: %s", err)
 	}
 
+	var loggingConfiguration *waf.LoggingConfiguration
+	loggingConfigurationResp, err := conn.GetLoggingConfiguration(&waf.GetLoggingConfigurationInput{
+		ResourceArn: resp.WebACL.WebACLArn,
+	})
+	if err != nil && !isAWSErr(err, waf.ErrCodeNonexistentItemException, "") {
+		return fmt.Errorf("error getting WAF ACL (%s) logging configuration: %s", d.Id(), err)
+	}
+	if loggingConfigurationResp != nil {
+		loggingConfiguration = loggingConfigurationResp.LoggingConfiguration
+	}
+	if err := d.Set("logging_configuration", flattenWafWebAclLoggingConfiguration(loggingConfiguration)); err != nil {
+		return fmt.Errorf("error setting logging_configuration: %s", err)
+	}
+
+	tagsResp, err := conn.ListTagsForResource(&waf.ListTagsForResourceInput{
+		ResourceARN: resp.WebACL.WebACLArn,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for WAF ACL (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", tagsToMapWaf(tagsResp.TagInfoForResource.TagList)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
 	return nil
 }
 
 func resourceAwsWafWebAclUpdate(d *schema.ResourceData, meta interface{}) error {
-	err := updateWebAclResource(d, meta, waf.ChangeActionInsert)
-	if err != nil {
-		return fmt.Errorf("Error Updating WAF ACL: %s", err)
+	conn := meta.(*AWSClient).wafconn
+
+	if d.HasChange("default_action") || d.HasChange("rules") {
+		o, n := d.GetChange("rules")
+		updates := diffWafWebAclRules(o.([]interface{}), n.([]interface{}))
+
+		var defaultAction *waf.WafAction
+		if d.HasChange("default_action") {
+			defaultAction = expandDefaultAction(d)
+		}
+
+		if err := applyWafWebAclUpdates(conn, d, updates, defaultAction); err != nil {
+			return fmt.Errorf("Error Updating WAF ACL: %s", err)
+		}
 	}
+
+	if d.HasChange("logging_configuration") {
+		if err := updateWafWebAclLoggingConfiguration(d, conn); err != nil {
+			return err
+		}
+	}
+
+	if !d.IsNewResource() {
+		if err := setWafTags(conn, d); err != nil {
+			return fmt.Errorf("Error updating WAF ACL (%s) tags: %s", d.Id(), err)
+		}
+	}
+
 	return resourceAwsWafWebAclRead(d, meta)
 }
 
 func resourceAwsWafWebAclDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).wafconn
-	err := updateWebAclResource(d, meta, waf.ChangeActionDelete)
-	if err != nil {
-		return fmt.Errorf("Error Removing WAF ACL Rules: %s", err)
+
+	if len(d.Get("logging_configuration").([]interface{})) > 0 {
+		log.Printf("[INFO] Deleting WAF ACL (%s) logging configuration", d.Id())
+		_, err := conn.DeleteLoggingConfiguration(&waf.DeleteLoggingConfigurationInput{
+			ResourceArn: aws.String(d.Get("arn").(string)),
+		})
+		if err != nil && !isAWSErr(err, waf.ErrCodeNonexistentItemException, "") {
+			return fmt.Errorf("Error deleting WAF ACL (%s) logging configuration: %s", d.Id(), err)
+		}
+	}
+
+	rules := d.Get("rules").([]interface{})
+	if len(rules) > 0 {
+		updates := make([]*waf.WebACLUpdate, len(rules))
+		for i, rule := range rules {
+			updates[i] = webAclRuleUpdate(waf.ChangeActionDelete, rule.(map[string]interface{}))
+		}
+
+		if err := applyWafWebAclUpdates(conn, d, updates, nil); err != nil {
+			return fmt.Errorf("Error Removing WAF ACL Rules: %s", err)
+		}
 	}
 
 	wr := newWafRetryer(conn, "global")
-	_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
 		req := &waf.DeleteWebACLInput{
 			ChangeToken: token,
 			WebACLId:    aws.String(d.Id()),
@@ -186,57 +313,119 @@ func resourceAwsWafWebAclDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func updateWebAclResource(d *schema.ResourceData, meta interface{}, ChangeAction string) error {
-	conn := meta.(*AWSClient).wafconn
+// diffWafWebAclRules compares the old and new values of the rules list,
+// keyed by rule_id since priority and other attributes may also change, and
+// returns the set of WebACLUpdates needed to bring AWS in line with the new
+// configuration. A rule present in both old and new but with a changed
+// action/override_action/priority is expressed as a delete of the old
+// ActivatedRule paired with an insert of the new one, since UpdateWebACL has
+// no "modify" change action.
+func diffWafWebAclRules(oldRules, newRules []interface{}) []*waf.WebACLUpdate {
+	oldByID := make(map[string]map[string]interface{}, len(oldRules))
+	for _, r := range oldRules {
+		rule := r.(map[string]interface{})
+		oldByID[rule["rule_id"].(string)] = rule
+	}
 
-	wr := newWafRetryer(conn, "global")
-	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
-		req := &waf.UpdateWebACLInput{
-			ChangeToken: token,
-			WebACLId:    aws.String(d.Id()),
+	newByID := make(map[string]map[string]interface{}, len(newRules))
+	for _, r := range newRules {
+		rule := r.(map[string]interface{})
+		newByID[rule["rule_id"].(string)] = rule
+	}
+
+	var updates []*waf.WebACLUpdate
+
+	for ruleID, oldRule := range oldByID {
+		newRule, ok := newByID[ruleID]
+		if !ok {
+			updates = append(updates, webAclRuleUpdate(waf.ChangeActionDelete, oldRule))
+			continue
+		}
+		if !wafWebAclRuleEqual(oldRule, newRule) {
+			updates = append(updates, webAclRuleUpdate(waf.ChangeActionDelete, oldRule))
+			updates = append(updates, webAclRuleUpdate(waf.ChangeActionInsert, newRule))
 		}
+	}
 
-		if d.HasChange("default_action") {
-			req.DefaultAction = expandDefaultAction(d)
+	for ruleID, newRule := range newByID {
+		if _, ok := oldByID[ruleID]; !ok {
+			updates = append(updates, webAclRuleUpdate(waf.ChangeActionInsert, newRule))
 		}
+	}
 
-		rules := d.Get("rules").(*schema.Set)
-		for _, rule := range rules.List() {
-			aclRule := rule.(map[string]interface{})
-
-			var aclRuleUpdate *waf.WebACLUpdate
-			switch aclRule["type"].(string) {
-			case waf.WafRuleTypeGroup:
-				overrideAction := aclRule["override_action"].([]interface{})[0].(map[string]interface{})
-				aclRuleUpdate = &waf.WebACLUpdate{
-					Action: aws.String(ChangeAction),
-					ActivatedRule: &waf.ActivatedRule{
-						Priority:       aws.Int64(int64(aclRule["priority"].(int))),
-						RuleId:         aws.String(aclRule["rule_id"].(string)),
-						Type:           aws.String(aclRule["type"].(string)),
-						OverrideAction: &waf.WafOverrideAction{Type: aws.String(overrideAction["type"].(string))},
-					},
-				}
-			default:
-				action := aclRule["action"].([]interface{})[0].(map[string]interface{})
-				aclRuleUpdate = &waf.WebACLUpdate{
-					Action: aws.String(ChangeAction),
-					ActivatedRule: &waf.ActivatedRule{
-						Priority: aws.Int64(int64(aclRule["priority"].(int))),
-						RuleId:   aws.String(aclRule["rule_id"].(string)),
-						Type:     aws.String(aclRule["type"].(string)),
-						Action:   &waf.WafAction{Type: aws.String(action["type"].(string))},
-					},
-				}
-			}
+	return updates
+}
+
+func wafWebAclRuleEqual(a, b map[string]interface{}) bool {
+	return a["priority"].(int) == b["priority"].(int) &&
+		a["type"].(string) == b["type"].(string) &&
+		reflect.DeepEqual(a["action"], b["action"]) &&
+		reflect.DeepEqual(a["override_action"], b["override_action"])
+}
 
-			req.Updates = append(req.Updates, aclRuleUpdate)
+func webAclRuleUpdate(changeAction string, aclRule map[string]interface{}) *waf.WebACLUpdate {
+	switch aclRule["type"].(string) {
+	case waf.WafRuleTypeGroup:
+		overrideAction := aclRule["override_action"].([]interface{})[0].(map[string]interface{})
+		return &waf.WebACLUpdate{
+			Action: aws.String(changeAction),
+			ActivatedRule: &waf.ActivatedRule{
+				Priority:       aws.Int64(int64(aclRule["priority"].(int))),
+				RuleId:         aws.String(aclRule["rule_id"].(string)),
+				Type:           aws.String(aclRule["type"].(string)),
+				OverrideAction: &waf.WafOverrideAction{Type: aws.String(overrideAction["type"].(string))},
+			},
 		}
-		return conn.UpdateWebACL(req)
-	})
-	if err != nil {
-		return fmt.Errorf("Error Updating WAF ACL: %s", err)
+	default:
+		action := aclRule["action"].([]interface{})[0].(map[string]interface{})
+		return &waf.WebACLUpdate{
+			Action: aws.String(changeAction),
+			ActivatedRule: &waf.ActivatedRule{
+				Priority: aws.Int64(int64(aclRule["priority"].(int))),
+				RuleId:   aws.String(aclRule["rule_id"].(string)),
+				Type:     aws.String(aclRule["type"].(string)),
+				Action:   &waf.WafAction{Type: aws.String(action["type"].(string))},
+			},
+		}
+	}
+}
+
+// applyWafWebAclUpdates issues one or more UpdateWebACL calls to apply
+// updates, chunking at wafWebAclMaxBatchUpdates per AWS's cap on the number
+// of Updates in a single request. defaultAction, if set, is only sent with
+// the first chunk.
+func applyWafWebAclUpdates(conn *waf.WAF, d *schema.ResourceData, updates []*waf.WebACLUpdate, defaultAction *waf.WafAction) error {
+	if len(updates) == 0 && defaultAction == nil {
+		return nil
 	}
+
+	wr := newWafRetryer(conn, "global")
+	remaining := updates
+
+	for first := true; first || len(remaining) > 0; first = false {
+		chunk := remaining
+		if len(chunk) > wafWebAclMaxBatchUpdates {
+			chunk = chunk[:wafWebAclMaxBatchUpdates]
+		}
+
+		_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+			req := &waf.UpdateWebACLInput{
+				ChangeToken: token,
+				WebACLId:    aws.String(d.Id()),
+				Updates:     chunk,
+			}
+			if first {
+				req.DefaultAction = defaultAction
+			}
+			return conn.UpdateWebACL(req)
+		})
+		if err != nil {
+			return err
+		}
+
+		remaining = remaining[len(chunk):]
+	}
+
 	return nil
 }
 
@@ -273,3 +462,95 @@ func flattenDefaultAction(n *waf.WafAction) []map[string]interface{} {
 	m.SetString("type", n.Type)
 	return m.MapList()
 }
+
+func updateWafWebAclLoggingConfiguration(d *schema.ResourceData, conn *waf.WAF) error {
+	loggingConfiguration := d.Get("logging_configuration").([]interface{})
+
+	if len(loggingConfiguration) == 0 {
+		log.Printf("[INFO] Deleting WAF ACL (%s) logging configuration", d.Id())
+		_, err := conn.DeleteLoggingConfiguration(&waf.DeleteLoggingConfigurationInput{
+			ResourceArn: aws.String(d.Get("arn").(string)),
+		})
+		if err != nil && !isAWSErr(err, waf.ErrCodeNonexistentItemException, "") {
+			return fmt.Errorf("Error deleting WAF ACL (%s) logging configuration: %s", d.Id(), err)
+		}
+		return nil
+	}
+
+	m := loggingConfiguration[0].(map[string]interface{})
+
+	log.Printf("[INFO] Updating WAF ACL (%s) logging configuration", d.Id())
+	_, err := conn.PutLoggingConfiguration(&waf.PutLoggingConfigurationInput{
+		LoggingConfiguration: &waf.LoggingConfiguration{
+			LogDestinationConfigs: []*string{aws.String(m["log_destination"].(string))},
+			RedactedFields:        expandWafRedactedFields(m["redacted_fields"].([]interface{})),
+			ResourceArn:           aws.String(d.Get("arn").(string)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating WAF ACL (%s) logging configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandWafRedactedFields(in []interface{}) []*waf.FieldToMatch {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+
+	fields := in[0].(map[string]interface{})["field_to_match"].([]interface{})
+	redactedFields := make([]*waf.FieldToMatch, len(fields))
+
+	for i, field := range fields {
+		f := field.(map[string]interface{})
+		redactedField := &waf.FieldToMatch{
+			Type: aws.String(f["type"].(string)),
+		}
+		if v, ok := f["data"].(string); ok && v != "" {
+			redactedField.Data = aws.String(v)
+		}
+		redactedFields[i] = redactedField
+	}
+
+	return redactedFields
+}
+
+func flattenWafRedactedFields(fields []*waf.FieldToMatch) []interface{} {
+	if len(fields) == 0 {
+		return []interface{}{}
+	}
+
+	fieldToMatch := make([]interface{}, len(fields))
+	for i, field := range fields {
+		m := map[string]interface{}{
+			"type": aws.StringValue(field.Type),
+		}
+		if field.Data != nil {
+			m["data"] = aws.StringValue(field.Data)
+		}
+		fieldToMatch[i] = m
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"field_to_match": fieldToMatch,
+		},
+	}
+}
+
+func flattenWafWebAclLoggingConfiguration(lc *waf.LoggingConfiguration) []interface{} {
+	if lc == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"redacted_fields": flattenWafRedactedFields(lc.RedactedFields),
+	}
+
+	if len(lc.LogDestinationConfigs) > 0 {
+		m["log_destination"] = aws.StringValue(lc.LogDestinationConfigs[0])
+	}
+
+	return []interface{}{m}
+}
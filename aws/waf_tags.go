@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// diffWafTags takes the old and new tag sets for a WAF resource and returns
+// the set of tags that must be created/updated and the set that must be
+// removed, mirroring diffTags used for EC2-style resources.
+func diffWafTags(oldTags, newTags []*waf.Tag) ([]*waf.Tag, []*waf.Tag) {
+	create := make(map[string]interface{})
+	for _, t := range newTags {
+		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	var remove []*waf.Tag
+	for _, t := range oldTags {
+		old, ok := create[aws.StringValue(t.Key)]
+		if !ok || old != aws.StringValue(t.Value) {
+			remove = append(remove, t)
+		} else if ok {
+			delete(create, aws.StringValue(t.Key))
+		}
+	}
+
+	return tagsFromMapWaf(create), remove
+}
+
+func tagsFromMapWaf(m map[string]interface{}) []*waf.Tag {
+	result := make([]*waf.Tag, 0, len(m))
+	for k, v := range m {
+		t := &waf.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		}
+		if !tagIgnoredWaf(t) {
+			result = append(result, t)
+		}
+	}
+
+	return result
+}
+
+func tagsToMapWaf(ts []*waf.Tag) map[string]string {
+	result := make(map[string]string)
+	for _, t := range ts {
+		if !tagIgnoredWaf(t) {
+			result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+		}
+	}
+
+	return result
+}
+
+// tagIgnoredWaf compares a tag against a list of strings and checks if it should
+// be ignored or not
+func tagIgnoredWaf(t *waf.Tag) bool {
+	filter := []string{"^aws:"}
+	for _, v := range filter {
+		log.Printf("[DEBUG] Matching %v with %v\n", v, aws.StringValue(t.Key))
+		if r, _ := regexp.MatchString(v, aws.StringValue(t.Key)); r {
+			log.Printf("[DEBUG] Found AWS WAF specific tag %s (val: %s), ignoring.\n", aws.StringValue(t.Key), aws.StringValue(t.Value))
+			return true
+		}
+	}
+	return false
+}
+
+// createWafTags applies the configured tags to a freshly created WAF
+// resource identified by arn.
+func createWafTags(conn *waf.WAF, arn string, tagsMap map[string]interface{}) error {
+	tags := tagsFromMapWaf(tagsMap)
+	if len(tags) == 0 {
+		return nil
+	}
+
+	_, err := conn.TagResource(&waf.TagResourceInput{
+		ResourceARN: aws.String(arn),
+		Tags:        tags,
+	})
+	return err
+}
+
+// setWafTags diffs the tags in state against the configured tags for arn and
+// issues TagResource/UntagResource calls to reconcile them.
+func setWafTags(conn *waf.WAF, d *schema.ResourceData) error {
+	if !d.HasChange("tags") {
+		return nil
+	}
+
+	arn := d.Get("arn").(string)
+	o, n := d.GetChange("tags")
+	create, remove := diffWafTags(tagsFromMapWaf(o.(map[string]interface{})), tagsFromMapWaf(n.(map[string]interface{})))
+
+	if len(remove) > 0 {
+		log.Printf("[DEBUG] Removing WAF tags: %#v", remove)
+		keys := make([]*string, 0, len(remove))
+		for _, t := range remove {
+			keys = append(keys, t.Key)
+		}
+
+		if _, err := conn.UntagResource(&waf.UntagResourceInput{
+			ResourceARN: aws.String(arn),
+			TagKeys:     keys,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(create) > 0 {
+		log.Printf("[DEBUG] Creating WAF tags: %#v", create)
+		if _, err := conn.TagResource(&waf.TagResourceInput{
+			ResourceARN: aws.String(arn),
+			Tags:        create,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}